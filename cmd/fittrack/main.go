@@ -0,0 +1,51 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	fittrack "fitTrack"
+)
+
+func main() {
+
+	swimming := fittrack.Swimming{
+		Training: fittrack.Training{
+			TrainingType: "Плавание",
+			Action:       2000,
+			LenStep:      fittrack.SwimmingLenStep,
+			Duration:     90 * time.Minute,
+			Weight:       85,
+		},
+		LengthPool: 50,
+		CountPool:  5,
+	}
+
+	fmt.Println(fittrack.ReadData(swimming))
+
+	walking := fittrack.Walking{
+		Training: fittrack.Training{
+			TrainingType: "Ходьба",
+			Action:       20000,
+			LenStep:      fittrack.LenStep,
+			Duration:     3*time.Hour + 45*time.Minute,
+			Weight:       85,
+		},
+		Height: 185,
+	}
+
+	fmt.Println(fittrack.ReadData(walking))
+
+	running := fittrack.Running{
+		Training: fittrack.Training{
+			TrainingType: "Бег",
+			Action:       5000,
+			LenStep:      fittrack.LenStep,
+			Duration:     30 * time.Minute,
+			Weight:       85,
+		},
+	}
+
+	fmt.Println(fittrack.ReadData(running))
+
+}
@@ -0,0 +1,358 @@
+// Package fitimport разбирает файлы активности, выгруженные с фитнес-устройств
+// (Garmin FIT, GPX), и строит из них значения fittrack.CaloriesCalculator.
+package fitimport
+
+import (
+	"encoding/binary"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"time"
+
+	fittrack "fitTrack"
+)
+
+// Коды видов спорта согласно глобальному профилю FIT SDK (Garmin).
+const (
+	fitSportRunning  = 1
+	fitSportCycling  = 2
+	fitSportSwimming = 5
+	fitSportWalking  = 11
+)
+
+// Options задает биометрические данные пользователя, которые FIT/GPX-файлы
+// не содержат, но которые нужны формулам расчета калорий в пакете fittrack.
+type Options struct {
+	Weight float64             // вес пользователя в кг
+	HR     *fittrack.HeartRate // данные пульса для HR-уточненного расчета калорий, опционально
+}
+
+// buildTraining строит тренировку нужного типа по просуммированным за
+// активность дистанции и длительности.
+func buildTraining(sport uint8, distanceM float64, duration time.Duration, laps []fittrack.InfoMessage, opts Options) (fittrack.CaloriesCalculator, error) {
+	switch sport {
+	case fitSportSwimming:
+		const lengthPool = 50 // длина бассейна FIT/GPX не сообщают, используем стандартные 50 м
+		return fittrack.Swimming{
+			Training: fittrack.Training{
+				TrainingType: "Плавание",
+				LenStep:      fittrack.SwimmingLenStep,
+				Duration:     duration,
+				Weight:       opts.Weight,
+				Laps:         laps,
+			},
+			LengthPool: lengthPool,
+			CountPool:  int(distanceM / lengthPool),
+			HR:         opts.HR,
+		}, nil
+	case fitSportWalking:
+		return fittrack.Walking{
+			Training: fittrack.Training{
+				TrainingType: "Ходьба",
+				Action:       actionFromDistance(distanceM, fittrack.LenStep),
+				LenStep:      fittrack.LenStep,
+				Duration:     duration,
+				Weight:       opts.Weight,
+				Laps:         laps,
+			},
+			HR: opts.HR,
+		}, nil
+	case fitSportRunning:
+		return fittrack.Running{
+			Training: fittrack.Training{
+				TrainingType: "Бег",
+				Action:       actionFromDistance(distanceM, fittrack.LenStep),
+				LenStep:      fittrack.LenStep,
+				Duration:     duration,
+				Weight:       opts.Weight,
+				Laps:         laps,
+			},
+			HR: opts.HR,
+		}, nil
+	case fitSportCycling:
+		const wheelCircumferenceM = 2.1 // длина окружности колеса FIT/GPX не сообщают, используем стандартное значение
+		return fittrack.Cycling{
+			Training: fittrack.Training{
+				TrainingType: "Велоспорт",
+				Action:       actionFromDistance(distanceM, wheelCircumferenceM),
+				LenStep:      wheelCircumferenceM,
+				Duration:     duration,
+				Weight:       opts.Weight,
+				Laps:         laps,
+			},
+		}, nil
+	default:
+		return nil, fmt.Errorf("fitimport: unsupported sport %d", sport)
+	}
+}
+
+// actionFromDistance переводит пройденную дистанцию в количество шагов/гребков,
+// чтобы заполнить Training.Action так же, как это делают вручную собранные тренировки.
+func actionFromDistance(distanceM, lenStep float64) int {
+	if lenStep == 0 {
+		return 0
+	}
+	return int(distanceM / lenStep)
+}
+
+// ParseFIT разбирает минимально необходимое подмножество бинарного протокола
+// FIT: заголовок файла, сообщения-определения и сообщения session/lap. Данные
+// из session (total_distance, total_elapsed_time, sport) формируют итоговую
+// тренировку, а сообщения lap — InfoMessage.Laps. opts задает биометрию
+// пользователя (вес, пульс), которой сам FIT-файл не несет. Сжатые временные
+// метки заголовков записей (compressed timestamp header) не поддерживаются.
+func ParseFIT(r io.Reader, opts Options) ([]fittrack.CaloriesCalculator, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("fitimport: read fit: %w", err)
+	}
+	if len(data) < 12 {
+		return nil, errors.New("fitimport: file too short to be a FIT file")
+	}
+
+	headerSize := int(data[0])
+	if headerSize < 12 || len(data) < headerSize {
+		return nil, errors.New("fitimport: invalid FIT header")
+	}
+	if string(data[8:12]) != ".FIT" {
+		return nil, errors.New("fitimport: missing .FIT signature")
+	}
+	dataSize := int(binary.LittleEndian.Uint32(data[4:8]))
+	if headerSize+dataSize > len(data) {
+		return nil, errors.New("fitimport: truncated FIT file")
+	}
+
+	laps, sport, distanceM, duration, err := decodeFITRecords(data[headerSize : headerSize+dataSize])
+	if err != nil {
+		return nil, err
+	}
+
+	training, err := buildTraining(sport, distanceM, duration, laps, opts)
+	if err != nil {
+		return nil, err
+	}
+	return []fittrack.CaloriesCalculator{training}, nil
+}
+
+// fitFieldDef описывает одно поле сообщения из сообщения-определения FIT.
+type fitFieldDef struct {
+	Num  uint8
+	Size uint8
+}
+
+// fitMesgDef описывает сообщение-определение FIT для одного local message type.
+type fitMesgDef struct {
+	GlobalMesgNum uint16
+	BigEndian     bool
+	Fields        []fitFieldDef
+}
+
+// Номера глобальных сообщений и полей FIT, которые нам нужны (session и lap).
+const (
+	fitMesgNumSession = 18
+	fitMesgNumLap     = 19
+
+	fitFieldSport            = 5
+	fitFieldTotalElapsedTime = 7
+	fitFieldTotalDistance    = 9
+)
+
+// decodeFITRecords проходит по записям FIT-файла и накапливает итоги session
+// и список lap-ов.
+func decodeFITRecords(body []byte) (laps []fittrack.InfoMessage, sport uint8, distanceM float64, duration time.Duration, err error) {
+	defs := make(map[uint8]fitMesgDef)
+	sport = 255 // вид спорта ещё не встретился
+	var haveSession bool
+	var elapsedSeconds float64
+
+	pos := 0
+	for pos < len(body) {
+		header := body[pos]
+		pos++
+		if header&0x80 != 0 {
+			return nil, 0, 0, 0, errors.New("fitimport: compressed timestamp headers are not supported")
+		}
+		localType := header & 0x0F
+
+		if header&0x40 != 0 { // сообщение-определение
+			if pos+5 > len(body) {
+				return nil, 0, 0, 0, errors.New("fitimport: truncated definition message")
+			}
+			bigEndian := body[pos+1] == 1
+			var globalNum uint16
+			if bigEndian {
+				globalNum = binary.BigEndian.Uint16(body[pos+2 : pos+4])
+			} else {
+				globalNum = binary.LittleEndian.Uint16(body[pos+2 : pos+4])
+			}
+			numFields := int(body[pos+4])
+			pos += 5
+
+			def := fitMesgDef{GlobalMesgNum: globalNum, BigEndian: bigEndian}
+			for i := 0; i < numFields; i++ {
+				if pos+3 > len(body) {
+					return nil, 0, 0, 0, errors.New("fitimport: truncated field definition")
+				}
+				def.Fields = append(def.Fields, fitFieldDef{Num: body[pos], Size: body[pos+1]})
+				pos += 3 // номер поля, размер, базовый тип
+			}
+			defs[localType] = def
+			continue
+		}
+
+		def, ok := defs[localType]
+		if !ok {
+			return nil, 0, 0, 0, fmt.Errorf("fitimport: data message for undefined local type %d", localType)
+		}
+
+		var lapInfo fittrack.InfoMessage
+		isLap := def.GlobalMesgNum == fitMesgNumLap
+		isSession := def.GlobalMesgNum == fitMesgNumSession
+
+		for _, f := range def.Fields {
+			if pos+int(f.Size) > len(body) {
+				return nil, 0, 0, 0, errors.New("fitimport: truncated data message")
+			}
+			raw := body[pos : pos+int(f.Size)]
+			pos += int(f.Size)
+
+			if !isSession && !isLap {
+				continue
+			}
+			switch f.Num {
+			case fitFieldSport:
+				if isSession && f.Size == 1 {
+					sport = raw[0]
+				}
+			case fitFieldTotalElapsedTime:
+				seconds := float64(decodeUint(raw, def.BigEndian)) / 1000
+				if isSession {
+					elapsedSeconds = seconds
+					haveSession = true
+				} else {
+					lapInfo.Duration = time.Duration(seconds * float64(time.Second))
+				}
+			case fitFieldTotalDistance:
+				meters := float64(decodeUint(raw, def.BigEndian)) / 100
+				if isSession {
+					distanceM = meters
+				} else {
+					lapInfo.Distance = meters / fittrack.MInKm
+				}
+			}
+		}
+
+		if isLap {
+			laps = append(laps, lapInfo)
+		}
+	}
+
+	if !haveSession {
+		return nil, 0, 0, 0, errors.New("fitimport: no session message found in FIT file")
+	}
+	return laps, sport, distanceM, time.Duration(elapsedSeconds * float64(time.Second)), nil
+}
+
+// decodeUint читает значение переменной длины как беззнаковое целое с учетом
+// порядка байт, заданного архитектурой сообщения-определения.
+func decodeUint(b []byte, bigEndian bool) uint64 {
+	var v uint64
+	if bigEndian {
+		for _, x := range b {
+			v = v<<8 | uint64(x)
+		}
+		return v
+	}
+	for i := len(b) - 1; i >= 0; i-- {
+		v = v<<8 | uint64(b[i])
+	}
+	return v
+}
+
+// gpxDoc описывает минимальное подмножество схемы GPX, необходимое для
+// восстановления тренировки: треки с точками (широта/долгота/время).
+type gpxDoc struct {
+	XMLName xml.Name   `xml:"gpx"`
+	Tracks  []gpxTrack `xml:"trk"`
+}
+
+type gpxTrack struct {
+	Type     string       `xml:"type"`
+	Segments []gpxSegment `xml:"trkseg"`
+}
+
+type gpxSegment struct {
+	Points []gpxPoint `xml:"trkpt"`
+}
+
+type gpxPoint struct {
+	Lat  float64   `xml:"lat,attr"`
+	Lon  float64   `xml:"lon,attr"`
+	Time time.Time `xml:"time"`
+}
+
+// earthRadiusM средний радиус Земли в метрах для расчета дистанции по формуле гаверсинуса.
+const earthRadiusM = 6371000
+
+// haversineDistance возвращает расстояние в метрах между двумя точками на сфере.
+func haversineDistance(lat1, lon1, lat2, lon2 float64) float64 {
+	toRad := func(deg float64) float64 { return deg * math.Pi / 180 }
+	dLat := toRad(lat2 - lat1)
+	dLon := toRad(lon2 - lon1)
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRad(lat1))*math.Cos(toRad(lat2))*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return earthRadiusM * 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+}
+
+// sportFromGPXType переводит текст тега <type> трека в код вида спорта FIT.
+func sportFromGPXType(t string) uint8 {
+	switch strings.ToLower(strings.TrimSpace(t)) {
+	case "walking", "hiking":
+		return fitSportWalking
+	case "swimming":
+		return fitSportSwimming
+	case "cycling", "biking":
+		return fitSportCycling
+	default:
+		return fitSportRunning
+	}
+}
+
+// ParseGPX разбирает GPX-файл и возвращает по одной тренировке на каждый трек
+// (<trk>). Дистанция считается по формуле гаверсинуса между соседними точками,
+// длительность — по разнице временных меток первой и последней точки. Треки,
+// в которых меньше двух точек, пропускаются. opts
+// задает биометрию пользователя (вес, пульс), которой сам GPX-файл не несет.
+func ParseGPX(r io.Reader, opts Options) ([]fittrack.CaloriesCalculator, error) {
+	var doc gpxDoc
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("fitimport: decode gpx: %w", err)
+	}
+
+	var result []fittrack.CaloriesCalculator
+	for _, trk := range doc.Tracks {
+		var points []gpxPoint
+		for _, seg := range trk.Segments {
+			points = append(points, seg.Points...)
+		}
+		if len(points) < 2 {
+			continue
+		}
+
+		var distanceM float64
+		for i := 1; i < len(points); i++ {
+			distanceM += haversineDistance(points[i-1].Lat, points[i-1].Lon, points[i].Lat, points[i].Lon)
+		}
+		duration := points[len(points)-1].Time.Sub(points[0].Time)
+
+		training, err := buildTraining(sportFromGPXType(trk.Type), distanceM, duration, nil, opts)
+		if err != nil {
+			continue // неподдерживаемый вид спорта
+		}
+		result = append(result, training)
+	}
+	return result, nil
+}
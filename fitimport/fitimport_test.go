@@ -0,0 +1,122 @@
+package fitimport
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strings"
+	"testing"
+)
+
+// buildFITSession собирает минимальный валидный FIT-файл с одним сообщением
+// session (sport, total_elapsed_time, total_distance), без lap-ов.
+func buildFITSession(sport byte, elapsedMs, distanceCm uint32) []byte {
+	var body []byte
+	body = append(body,
+		0x40,       // заголовок сообщения-определения, local type 0
+		0x00,       // reserved
+		0x00,       // architecture: little-endian
+		0x12, 0x00, // global mesg num 18 (session), little-endian
+		0x03,       // число полей
+		5, 1, 0x00, // sport: num=5, size=1
+		7, 4, 0x86, // total_elapsed_time: num=7, size=4
+		9, 4, 0x86, // total_distance: num=9, size=4
+	)
+
+	data := make([]byte, 10)
+	data[0] = 0x00 // заголовок сообщения данных, local type 0
+	data[1] = sport
+	binary.LittleEndian.PutUint32(data[2:6], elapsedMs)
+	binary.LittleEndian.PutUint32(data[6:10], distanceCm)
+	body = append(body, data...)
+
+	header := make([]byte, 12)
+	header[0] = 12 // headerSize
+	header[1] = 0x10
+	binary.LittleEndian.PutUint32(header[4:8], uint32(len(body)))
+	copy(header[8:12], ".FIT")
+
+	return append(header, body...)
+}
+
+func TestParseFIT_HappyPath(t *testing.T) {
+	const sport = 1 // running
+	data := buildFITSession(sport, 1800000, 500000)
+
+	trainings, err := ParseFIT(bytes.NewReader(data), Options{Weight: 80})
+	if err != nil {
+		t.Fatalf("ParseFIT: unexpected error: %v", err)
+	}
+	if len(trainings) != 1 {
+		t.Fatalf("ParseFIT: got %d trainings, want 1", len(trainings))
+	}
+
+	info := trainings[0].TrainingInfo()
+	if info.Distance <= 0 {
+		t.Errorf("ParseFIT: Distance = %v, want > 0", info.Distance)
+	}
+	if info.Calories <= 0 {
+		t.Errorf("ParseFIT: Calories = %v, want > 0 (weight was not propagated)", info.Calories)
+	}
+}
+
+func TestParseFIT_Malformed(t *testing.T) {
+	cases := map[string][]byte{
+		"too short":     {1, 2, 3},
+		"bad signature": func() []byte {
+			h := make([]byte, 12)
+			h[0] = 12
+			copy(h[8:12], "XXXX")
+			return h
+		}(),
+		"truncated data size": func() []byte {
+			h := make([]byte, 12)
+			h[0] = 12
+			binary.LittleEndian.PutUint32(h[4:8], 1000)
+			copy(h[8:12], ".FIT")
+			return h
+		}(),
+	}
+
+	for name, data := range cases {
+		t.Run(name, func(t *testing.T) {
+			if _, err := ParseFIT(bytes.NewReader(data), Options{}); err == nil {
+				t.Errorf("ParseFIT(%s): expected error, got nil", name)
+			}
+		})
+	}
+}
+
+const gpxHappyPath = `<?xml version="1.0"?>
+<gpx>
+  <trk>
+    <type>running</type>
+    <trkseg>
+      <trkpt lat="55.7500" lon="37.6170"><time>2024-01-01T10:00:00Z</time></trkpt>
+      <trkpt lat="55.7600" lon="37.6180"><time>2024-01-01T10:05:00Z</time></trkpt>
+    </trkseg>
+  </trk>
+</gpx>`
+
+func TestParseGPX_HappyPath(t *testing.T) {
+	trainings, err := ParseGPX(strings.NewReader(gpxHappyPath), Options{Weight: 80})
+	if err != nil {
+		t.Fatalf("ParseGPX: unexpected error: %v", err)
+	}
+	if len(trainings) != 1 {
+		t.Fatalf("ParseGPX: got %d trainings, want 1", len(trainings))
+	}
+
+	info := trainings[0].TrainingInfo()
+	if info.Distance <= 0 {
+		t.Errorf("ParseGPX: Distance = %v, want > 0", info.Distance)
+	}
+	if info.Calories <= 0 {
+		t.Errorf("ParseGPX: Calories = %v, want > 0 (weight was not propagated)", info.Calories)
+	}
+}
+
+func TestParseGPX_Malformed(t *testing.T) {
+	if _, err := ParseGPX(strings.NewReader("not xml at all <<<"), Options{}); err == nil {
+		t.Error("ParseGPX: expected error on malformed XML, got nil")
+	}
+}
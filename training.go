@@ -0,0 +1,784 @@
+// Package fittrack содержит общую модель тренировок (бег, ходьба, плавание,
+// силовые, велоспорт) и расчет потраченных калорий для них.
+package fittrack
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// Общие константы для вычислений.
+const (
+	MInKm      = 1000 // количество метров в одном километре
+	MinInHours = 60   // количество минут в одном часе
+	LenStep    = 0.65 // длина одного шага
+	CmInM      = 100  // количество сантиметров в одном метре
+)
+
+// Training общая структура для всех тренировок
+// Training общая структура для всех тренировок
+type Training struct {
+	TrainingType string        // тип тренировки
+	Action       int           // количество шагов/гребков
+	LenStep      float64       // длина одного шага/гребка в метрах
+	Duration     time.Duration // продолжительность тренировки
+	Weight       float64       // вес пользователя в кг
+	Laps         []InfoMessage // сведения об отдельных кругах/отрезках, если они известны (например, из импортированного файла)
+}
+
+// distance возвращает дистанцию, которую преодолел пользователь.
+func (t Training) distance() float64 {
+	return float64(t.Action) * t.LenStep / MInKm
+}
+
+// meanSpeed возвращает среднюю скорость бега или ходьбы.
+func (t Training) meanSpeed() float64 {
+	return t.distance() / t.Duration.Seconds() * 3600
+}
+
+// pace возвращает темп в десятичных минутах на километр.
+func (t Training) pace() float64 {
+	d := t.distance()
+	if d == 0 {
+		return 0
+	}
+	return t.Duration.Minutes() / d
+}
+
+// Calories возвращает количество потраченных килокалорий на тренировке.
+func (t Training) Calories() float64 {
+	return 0
+}
+
+// PaceUnit единица измерения темпа в InfoMessage.Pace.
+type PaceUnit int
+
+// Поддерживаемые единицы темпа.
+const (
+	PacePerKm   PaceUnit = iota // темп на километр (бег, ходьба)
+	PacePer100m                 // темп на 100 метров (плавание)
+)
+
+// InfoMessage содержит информацию о проведенной тренировке. Все величины
+// хранятся в системе СИ — перевод в другие единицы и перевод подписей
+// выполняет Formatter.
+type InfoMessage struct {
+	TrainingType   string
+	Duration       time.Duration
+	Distance       float64
+	Speed          float64
+	Calories       float64
+	HeartRateZones [5]time.Duration // время в зонах пульса 50-60/60-70/70-80/80-90/90-100% резерва
+	Volume         float64          // суммарный объем (Σ повторения × вес), кг, для силовых тренировок
+	SetCount       int              // количество подходов, для силовых тренировок
+	Laps           []InfoMessage    // сведения об отдельных кругах/отрезках, если они известны
+	Pace           float64          // темп в десятичных минутах на PaceUnit
+	PaceUnit       PaceUnit         // единица темпа
+	WeightKg       float64          // вес пользователя, кг
+	HeightCm       float64          // рост пользователя, см (только для Walking)
+	PoolLengthM    float64          // длина бассейна, м (только для Swimming)
+}
+
+// TrainingInfo возвращает структуру InfoMessage с информацией о проведенной тренировке.
+func (t Training) TrainingInfo() InfoMessage {
+	return InfoMessage{
+		TrainingType: t.TrainingType,
+		Duration:     t.Duration,
+		Distance:     t.distance(),
+		Speed:        t.meanSpeed(),
+		Calories:     t.Calories(),
+		Laps:         t.Laps,
+		Pace:         t.pace(),
+		PaceUnit:     PacePerKm,
+		WeightKg:     t.Weight,
+	}
+}
+
+// String возвращает строку с информацией о проведенной тренировке на русском
+// языке в метрической системе (эквивалент Format(Metric) с локалью RU).
+func (i InfoMessage) String() string {
+	return Formatter{Units: Metric, Locale: RU}.Format(i)
+}
+
+// Format возвращает строку с информацией о проведенной тренировке на русском
+// языке в выбранной системе единиц.
+func (i InfoMessage) Format(u UnitSystem) string {
+	return Formatter{Units: u, Locale: RU}.Format(i)
+}
+
+// decimalMinutesToMinSec форматирует десятичные минуты в строку "M:SS".
+func decimalMinutesToMinSec(f float64) string {
+	whole := math.Floor(f)
+	seconds := int(math.Round((f - whole) * 60))
+	if seconds == 60 {
+		seconds = 0
+		whole++
+	}
+	return fmt.Sprintf("%d:%02d", int(whole), seconds)
+}
+
+// UnitSystem система единиц измерения для отображения результатов тренировки.
+type UnitSystem int
+
+// Поддерживаемые системы единиц.
+const (
+	Metric   UnitSystem = iota // километры, км/ч, килограммы, сантиметры, метры
+	Imperial                   // мили, мили/ч, фунты, дюймы, ярды
+)
+
+// Locale язык подписей при форматировании результатов тренировки.
+type Locale int
+
+// Поддерживаемые локали.
+const (
+	RU Locale = iota
+	EN
+)
+
+// Коэффициенты перевода величин СИ в имперскую систему.
+const (
+	milesPerKm  = 0.621371
+	poundsPerKg = 2.20462
+	inchPerCm   = 0.393701
+	yardPerM    = 1.09361
+	mPer100Yd   = 91.44 // метров в 100 ярдах, используется для пересчета темпа плавания
+)
+
+// Formatter переводит внутренние значения InfoMessage (всегда хранящиеся в СИ)
+// в выбранную систему единиц измерения и язык подписей.
+type Formatter struct {
+	Units  UnitSystem
+	Locale Locale
+}
+
+// labels подписи и единицы измерения для одной локали/системы единиц.
+type labels struct {
+	typeLbl, durationLbl, distanceLbl, speedLbl, paceLbl, caloriesLbl string
+	volumeLbl, setsLbl, weightLbl, heightLbl, poolLbl, zonesLbl       string
+	durationUnit, distanceUnit, speedUnit, weightUnit, heightUnit     string
+	poolUnit, paceKmUnit, pace100mUnit                                string
+}
+
+func (f Formatter) labels() labels {
+	if f.Locale == EN {
+		l := labels{
+			typeLbl: "Training type", durationLbl: "Duration", distanceLbl: "Distance",
+			speedLbl: "Avg speed", paceLbl: "Pace", caloriesLbl: "Calories burned",
+			volumeLbl: "Volume", setsLbl: "Sets", weightLbl: "Weight", heightLbl: "Height",
+			poolLbl: "Pool length", zonesLbl: "HR zones",
+			durationUnit: "min", distanceUnit: "km", speedUnit: "km/h",
+			weightUnit: "kg", heightUnit: "cm", poolUnit: "m",
+			paceKmUnit: "km", pace100mUnit: "100 m",
+		}
+		if f.Units == Imperial {
+			l.distanceUnit, l.speedUnit = "mi", "mph"
+			l.weightUnit, l.heightUnit, l.poolUnit = "lb", "in", "yd"
+			l.paceKmUnit, l.pace100mUnit = "mi", "100 yd"
+		}
+		return l
+	}
+
+	l := labels{
+		typeLbl: "Тип тренировки", durationLbl: "Длительность", distanceLbl: "Дистанция",
+		speedLbl: "Ср. скорость", paceLbl: "Темп", caloriesLbl: "Потрачено ккал",
+		volumeLbl: "Объем", setsLbl: "Количество подходов", weightLbl: "Вес", heightLbl: "Рост",
+		poolLbl: "Длина бассейна", zonesLbl: "Зоны пульса",
+		durationUnit: "мин", distanceUnit: "км", speedUnit: "км/ч",
+		weightUnit: "кг", heightUnit: "см", poolUnit: "м",
+		paceKmUnit: "км", pace100mUnit: "100 м",
+	}
+	if f.Units == Imperial {
+		l.distanceUnit, l.speedUnit = "мили", "миль/ч"
+		l.weightUnit, l.heightUnit, l.poolUnit = "фунтов", "дюймов", "ярдов"
+		l.paceKmUnit, l.pace100mUnit = "милю", "100 ярдов"
+	}
+	return l
+}
+
+// Format рендерит InfoMessage в выбранной системе единиц и локали. Все данные
+// в InfoMessage хранятся в СИ — перевод происходит только здесь, на уровне
+// представления.
+func (f Formatter) Format(i InfoMessage) string {
+	l := f.labels()
+
+	distance, speed, volume, weight, height, pool := i.Distance, i.Speed, i.Volume, i.WeightKg, i.HeightCm, i.PoolLengthM
+	pace, paceUnit := i.Pace, l.paceKmUnit
+	if f.Units == Imperial {
+		distance *= milesPerKm
+		speed *= milesPerKm
+		volume *= poundsPerKg
+		weight *= poundsPerKg
+		height *= inchPerCm
+		pool *= yardPerM
+		if i.PaceUnit == PacePer100m {
+			pace *= mPer100Yd / 100
+		} else {
+			pace /= milesPerKm
+		}
+	}
+	if i.PaceUnit == PacePer100m {
+		paceUnit = l.pace100mUnit
+	}
+
+	s := fmt.Sprintf("%s: %s\n%s: %v %s\n", l.typeLbl, i.TrainingType, l.durationLbl, i.Duration.Minutes(), l.durationUnit)
+	if i.Distance != 0 || i.Speed != 0 {
+		s += fmt.Sprintf("%s: %.2f %s.\n%s: %.2f %s\n", l.distanceLbl, distance, l.distanceUnit, l.speedLbl, speed, l.speedUnit)
+		if i.Pace != 0 {
+			s += fmt.Sprintf("%s: %s %s/%s\n", l.paceLbl, decimalMinutesToMinSec(pace), l.durationUnit, paceUnit)
+		}
+	} else if i.SetCount > 0 {
+		s += fmt.Sprintf("%s: %.1f %s\n%s: %d\n", l.volumeLbl, volume, l.weightUnit, l.setsLbl, i.SetCount)
+	}
+	if height != 0 {
+		s += fmt.Sprintf("%s: %.0f %s\n", l.heightLbl, height, l.heightUnit)
+	}
+	if pool != 0 {
+		s += fmt.Sprintf("%s: %.0f %s\n", l.poolLbl, pool, l.poolUnit)
+	}
+	if weight != 0 {
+		s += fmt.Sprintf("%s: %.1f %s\n", l.weightLbl, weight, l.weightUnit)
+	}
+	s += fmt.Sprintf("%s: %.2f\n", l.caloriesLbl, i.Calories)
+	if i.HeartRateZones != [5]time.Duration{} {
+		u := l.durationUnit
+		s += fmt.Sprintf("%s: 50-60%%: %v %s, 60-70%%: %v %s, 70-80%%: %v %s, 80-90%%: %v %s, 90-100%%: %v %s\n",
+			l.zonesLbl,
+			i.HeartRateZones[0].Minutes(), u,
+			i.HeartRateZones[1].Minutes(), u,
+			i.HeartRateZones[2].Minutes(), u,
+			i.HeartRateZones[3].Minutes(), u,
+			i.HeartRateZones[4].Minutes(), u,
+		)
+	}
+	return s
+}
+
+// Sex пол пользователя, используется в формуле Кейтел для расчета калорий по пульсу.
+type Sex int
+
+// Возможные значения Sex.
+const (
+	SexMale Sex = iota
+	SexFemale
+)
+
+// HeartRate хранит данные пульса, записанные во время тренировки,
+// и биометрию пользователя, необходимую для формулы Кейтел.
+type HeartRate struct {
+	Samples        []int         // значения пульса за тренировку, уд/мин
+	SampleInterval time.Duration // интервал между замерами
+	Age            int           // возраст пользователя, лет
+	Sex            Sex           // пол пользователя
+	RestingHR      int           // пульс покоя, уд/мин
+	MaxHR          int           // максимальный пульс, уд/мин
+}
+
+// meanHR возвращает средний пульс по всем замерам.
+func (h *HeartRate) meanHR() float64 {
+	if h == nil || len(h.Samples) == 0 {
+		return 0
+	}
+	sum := 0
+	for _, s := range h.Samples {
+		sum += s
+	}
+	return float64(sum) / float64(len(h.Samples))
+}
+
+// Коэффициенты формулы Кейтел для расчета калорий по пульсу (кДж/мин переводятся в ккал/мин делением на KeytelKJToKcal).
+const (
+	KeytelMaleConst    = -55.0969
+	KeytelMaleHR       = 0.6309
+	KeytelMaleWeight   = 0.1988
+	KeytelMaleAge      = 0.2017
+	KeytelFemaleConst  = -20.4022
+	KeytelFemaleHR     = 0.4472
+	KeytelFemaleWeight = -0.1263
+	KeytelFemaleAge    = 0.074
+	KeytelKJToKcal     = 4.184
+)
+
+// Calories возвращает количество потраченных килокалорий, рассчитанное по формуле
+// Кейтел на основе среднего пульса, веса, возраста и пола пользователя.
+func (h *HeartRate) Calories(weight float64, duration time.Duration) float64 {
+	hr := h.meanHR()
+	var kcalPerMin float64
+	if h.Sex == SexFemale {
+		kcalPerMin = (KeytelFemaleConst + KeytelFemaleHR*hr + KeytelFemaleWeight*weight + KeytelFemaleAge*float64(h.Age)) / KeytelKJToKcal
+	} else {
+		kcalPerMin = (KeytelMaleConst + KeytelMaleHR*hr + KeytelMaleWeight*weight + KeytelMaleAge*float64(h.Age)) / KeytelKJToKcal
+	}
+	if kcalPerMin < 0 {
+		kcalPerMin = 0
+	}
+	return kcalPerMin * duration.Minutes()
+}
+
+// zoneBounds возвращает границы пяти зон пульса (резерв пульса по Карвонену: 50/60/70/80/90/100%).
+func (h *HeartRate) zoneBounds() [6]float64 {
+	reserve := float64(h.MaxHR - h.RestingHR)
+	percents := [6]float64{0.5, 0.6, 0.7, 0.8, 0.9, 1.0}
+	var bounds [6]float64
+	for i, p := range percents {
+		bounds[i] = reserve*p + float64(h.RestingHR)
+	}
+	return bounds
+}
+
+// Zones возвращает суммарное время, проведенное в каждой из пяти зон пульса
+// 50-60/60-70/70-80/80-90/90-100% резерва пульса (формула Карвонена).
+func (h *HeartRate) Zones() [5]time.Duration {
+	var zones [5]time.Duration
+	if h == nil {
+		return zones
+	}
+	bounds := h.zoneBounds()
+	for _, sample := range h.Samples {
+		hr := float64(sample)
+		for i := 0; i < 5; i++ {
+			if hr >= bounds[i] && hr <= bounds[i+1] {
+				zones[i] += h.SampleInterval
+				break
+			}
+		}
+	}
+	return zones
+}
+
+// CaloriesCalculator интерфейс для структур: Running, Walking, Swimming,
+// Cycling, Strength и HeartRateTraining.
+type CaloriesCalculator interface {
+	Calories() float64
+	TrainingInfo() InfoMessage
+}
+
+// evenSplits делит тренировку на n равных по дистанции и времени отрезков,
+// пропорционально распределяя калории по длительности отрезка. Остаток от
+// деления времени добавляется к последнему отрезку.
+func evenSplits(n int, trainingType string, totalDistance float64, totalDuration time.Duration, totalCalories float64, paceUnit PaceUnit, paceDivisorPerKm float64) []InfoMessage {
+	if n <= 0 {
+		return nil
+	}
+	distancePerSplit := totalDistance / float64(n)
+	durationPerSplit := totalDuration / time.Duration(n)
+	durationRemainder := totalDuration - durationPerSplit*time.Duration(n)
+
+	splits := make([]InfoMessage, 0, n)
+	for i := 0; i < n; i++ {
+		duration := durationPerSplit
+		if i == n-1 {
+			duration += durationRemainder
+		}
+
+		var speed, pace, calories float64
+		if duration > 0 {
+			speed = distancePerSplit / duration.Seconds() * 3600
+		}
+		if distancePerSplit > 0 {
+			pace = duration.Minutes() / (distancePerSplit * paceDivisorPerKm)
+		}
+		if totalDuration > 0 {
+			calories = totalCalories * duration.Minutes() / totalDuration.Minutes()
+		}
+
+		splits = append(splits, InfoMessage{
+			TrainingType: trainingType,
+			Duration:     duration,
+			Distance:     distancePerSplit,
+			Speed:        speed,
+			Pace:         pace,
+			PaceUnit:     paceUnit,
+			Calories:     calories,
+		})
+	}
+	return splits
+}
+
+// Константы для расчета потраченных килокалорий при беге.
+const (
+	CaloriesMeanSpeedMultiplier = 18   // множитель средней скорости бега
+	CaloriesMeanSpeedShift      = 1.79 // коэффициент изменения средней скорости
+)
+
+// Running структура, описывающая тренировку Бег.
+type Running struct {
+	Training
+	HR *HeartRate // данные пульса для HR-уточненного расчета калорий, опционально
+}
+
+// Calories возвращает количество потраченных килокалорий при беге.
+// Если заданы данные пульса, используется формула Кейтел вместо расчета по скорости.
+func (r Running) Calories() float64 {
+	if r.HR != nil {
+		return r.HR.Calories(r.Weight, r.Duration)
+	}
+	speed := r.meanSpeed()
+	return (CaloriesMeanSpeedMultiplier*speed + CaloriesMeanSpeedShift) * r.Weight / MInKm * r.Duration.Seconds() / 3600
+}
+
+// TrainingInfo возвращает структуру InfoMessage с информацией о проведенной тренировке.
+func (r Running) TrainingInfo() InfoMessage {
+	info := r.Training.TrainingInfo()
+	info.Calories = r.Calories()
+	if r.HR != nil {
+		info.HeartRateZones = r.HR.Zones()
+	}
+	return info
+}
+
+// EvenSplits делит тренировку на n равных отрезков и возвращает для каждого
+// дистанцию, длительность, темп и долю калорий, пропорциональную длительности отрезка.
+func (r Running) EvenSplits(n int) []InfoMessage {
+	return evenSplits(n, r.TrainingType, r.distance(), r.Duration, r.Calories(), PacePerKm, 1)
+}
+
+// Константы для расчета потраченных килокалорий при ходьбе.
+const (
+	CaloriesWeightMultiplier      = 0.035 // коэффициент для веса
+	CaloriesSpeedHeightMultiplier = 0.029 // коэффициент для роста
+	KmHInMsec                     = 0.278 // коэффициент для перевода км/ч в м/с
+)
+
+// Walking структура описывающая тренировку Ходьба
+type Walking struct {
+	Training
+	Height float64    // рост пользователя в сантиметрах
+	HR     *HeartRate // данные пульса для HR-уточненного расчета калорий, опционально
+}
+
+// Calories возвращает количество потраченных килокалорий при ходьбе.
+// Если заданы данные пульса, используется формула Кейтел вместо расчета по скорости и росту.
+func (w Walking) Calories() float64 {
+	if w.HR != nil {
+		return w.HR.Calories(w.Weight, w.Duration)
+	}
+	speed := w.meanSpeed() * KmHInMsec
+	heightInMeters := w.Height / CmInM
+	return (CaloriesWeightMultiplier*w.Weight + (speed*speed/heightInMeters)*CaloriesSpeedHeightMultiplier*w.Weight) * w.Duration.Seconds() / 3600
+}
+
+// TrainingInfo возвращает структуру InfoMessage с информацией о проведенной тренировке.
+func (w Walking) TrainingInfo() InfoMessage {
+	info := w.Training.TrainingInfo()
+	info.Calories = w.Calories()
+	info.HeightCm = w.Height
+	if w.HR != nil {
+		info.HeartRateZones = w.HR.Zones()
+	}
+	return info
+}
+
+// EvenSplits делит тренировку на n равных отрезков и возвращает для каждого
+// дистанцию, длительность, темп и долю калорий, пропорциональную длительности отрезка.
+func (w Walking) EvenSplits(n int) []InfoMessage {
+	return evenSplits(n, w.TrainingType, w.distance(), w.Duration, w.Calories(), PacePerKm, 1)
+}
+
+// Константы для расчета потраченных килокалорий при плавании.
+const (
+	SwimmingLenStep                  = 1.38 // длина одного гребка
+	SwimmingCaloriesMeanSpeedShift   = 1.1  // коэффициент изменения средней скорости
+	SwimmingCaloriesWeightMultiplier = 2    // множитель веса пользователя
+)
+
+// Swimming структура, описывающая тренировку Плавание
+// Swimming структура, описывающая тренировку Плавание
+type Swimming struct {
+	Training
+	LengthPool int        // длина бассейна в метрах
+	CountPool  int        // количество пересечений бассейна
+	HR         *HeartRate // данные пульса для HR-уточненного расчета калорий, опционально
+}
+
+// distance возвращает дистанцию, которую проплыл пользователь. Если заданы
+// Action и LenStep (количество гребков), используется их произведение, как и
+// для остальных тренировок; иначе дистанция считается по LengthPool*CountPool
+// (так ее восстанавливает fitimport, не знающий числа гребков).
+func (s Swimming) distance() float64 {
+	if s.Action != 0 && s.LenStep != 0 {
+		return s.Training.distance()
+	}
+	return float64(s.LengthPool*s.CountPool) / MInKm
+}
+
+// meanSpeed возвращает среднюю скорость при плавании по длине бассейна и
+// числу пересечений (LengthPool*CountPool), независимо от того, как считается
+// distance() выше.
+func (s Swimming) meanSpeed() float64 {
+	return float64(s.LengthPool*s.CountPool) / MInKm / s.Duration.Seconds() * 3600
+}
+
+// pace возвращает темп плавания в десятичных минутах на 100 метров.
+func (s Swimming) pace() float64 {
+	d := s.distance()
+	if d == 0 {
+		return 0
+	}
+	return s.Duration.Minutes() / (d * 10) // d в км, один интервал темпа — 100 м
+}
+
+// Calories возвращает количество калорий, потраченных при плавании.
+// Если заданы данные пульса, используется формула Кейтел вместо расчета по скорости.
+func (s Swimming) Calories() float64 {
+	if s.HR != nil {
+		return s.HR.Calories(s.Weight, s.Duration)
+	}
+	speed := s.meanSpeed()
+	return (speed + SwimmingCaloriesMeanSpeedShift) * SwimmingCaloriesWeightMultiplier * s.Weight * s.Duration.Seconds() / 3600
+}
+
+// TrainingInfo возвращает структуру InfoMessage с информацией о проведенной тренировке.
+func (s Swimming) TrainingInfo() InfoMessage {
+	info := InfoMessage{
+		TrainingType: s.TrainingType,
+		Duration:     s.Duration,
+		Distance:     s.distance(),
+		Speed:        s.meanSpeed(),
+		Calories:     s.Calories(),
+		Laps:         s.Laps,
+		Pace:         s.pace(),
+		PaceUnit:     PacePer100m,
+		WeightKg:     s.Weight,
+		PoolLengthM:  float64(s.LengthPool),
+	}
+	if s.HR != nil {
+		info.HeartRateZones = s.HR.Zones()
+	}
+	return info
+}
+
+// EvenSplits делит тренировку на n равных отрезков и возвращает для каждого
+// дистанцию, длительность, темп (мин/100 м) и долю калорий, пропорциональную
+// длительности отрезка.
+func (s Swimming) EvenSplits(n int) []InfoMessage {
+	return evenSplits(n, s.TrainingType, s.distance(), s.Duration, s.Calories(), PacePer100m, 10)
+}
+
+// Пороги средней скорости (км/ч) и соответствующие им MET для велотренировки
+// без данных о мощности.
+const (
+	CyclingMETSpeedLow    = 16 // км/ч, ниже — CyclingMETLow
+	CyclingMETSpeedMedium = 19
+	CyclingMETSpeedHigh   = 22
+	CyclingMETSpeedTop    = 25 // км/ч, ниже — CyclingMETTop, от этого значения и выше — CyclingMETMax
+
+	CyclingMETLow    = 4
+	CyclingMETMedium = 6
+	CyclingMETHigh   = 8
+	CyclingMETTop    = 10
+	CyclingMETMax    = 12
+)
+
+// Коэффициенты формулы расчета калорий при велотренировке по мощности.
+const (
+	JoulesPerKcal               = 4184 // джоулей в одной килокалории
+	CyclingMechanicalEfficiency = 0.24 // КПД перевода механической мощности в метаболическую
+)
+
+// Коэффициенты общей MET-формулы расчета калорий (силовая и велотренировка без данных о мощности).
+const (
+	METCaloriesMultiplier    = 3.5 // множитель MET
+	METCaloriesWeightDivider = 200 // делитель для веса пользователя
+)
+
+// Cycling структура, описывающая велотренировку. Дистанция (если заданы
+// Action и LenStep) считается по количеству оборотов колеса: Action — число
+// оборотов, LenStep — длина окружности колеса в метрах.
+type Cycling struct {
+	Training
+	AvgPowerW      float64 // средняя мощность, Вт
+	ElevationGainM float64 // суммарный набор высоты, м
+	Cadence        int     // средний каденс, об/мин, опционально
+}
+
+// meanSpeedMET возвращает MET по таблице в зависимости от средней скорости,
+// используется, когда данные о мощности отсутствуют.
+func (c Cycling) meanSpeedMET() float64 {
+	speed := c.meanSpeed()
+	switch {
+	case speed < CyclingMETSpeedLow:
+		return CyclingMETLow
+	case speed < CyclingMETSpeedMedium:
+		return CyclingMETMedium
+	case speed < CyclingMETSpeedHigh:
+		return CyclingMETHigh
+	case speed < CyclingMETSpeedTop:
+		return CyclingMETTop
+	default:
+		return CyclingMETMax
+	}
+}
+
+// Calories возвращает количество потраченных килокалорий при велотренировке.
+// Если известна средняя мощность, используется перевод механической работы в
+// метаболическую (КПД ~24%), иначе — оценка по MET-таблице от средней скорости.
+func (c Cycling) Calories() float64 {
+	if c.AvgPowerW > 0 {
+		return c.AvgPowerW * c.Duration.Seconds() / JoulesPerKcal / CyclingMechanicalEfficiency
+	}
+	return c.meanSpeedMET() * METCaloriesMultiplier * c.Weight / METCaloriesWeightDivider * c.Duration.Minutes()
+}
+
+// TrainingInfo возвращает структуру InfoMessage с информацией о проведенной тренировке.
+func (c Cycling) TrainingInfo() InfoMessage {
+	info := c.Training.TrainingInfo()
+	info.Calories = c.Calories()
+	return info
+}
+
+// EvenSplits делит тренировку на n равных отрезков и возвращает для каждого
+// дистанцию, длительность, темп и долю калорий, пропорциональную длительности отрезка.
+func (c Cycling) EvenSplits(n int) []InfoMessage {
+	return evenSplits(n, c.TrainingType, c.distance(), c.Duration, c.Calories(), PacePerKm, 1)
+}
+
+// HeartRateTraining структура, описывающая тренировку, калории которой
+// считаются по данным пульса (формула Кейтел), а не по дистанции и скорости.
+type HeartRateTraining struct {
+	Training
+	HR HeartRate
+}
+
+// Calories возвращает количество потраченных килокалорий по формуле Кейтел.
+func (t HeartRateTraining) Calories() float64 {
+	return t.HR.Calories(t.Weight, t.Duration)
+}
+
+// TrainingInfo возвращает структуру InfoMessage с информацией о проведенной тренировке.
+func (t HeartRateTraining) TrainingInfo() InfoMessage {
+	info := t.Training.TrainingInfo()
+	info.Calories = t.Calories()
+	info.HeartRateZones = t.HR.Zones()
+	return info
+}
+
+// EvenSplits делит тренировку на n равных отрезков и возвращает для каждого
+// дистанцию, длительность, темп и долю калорий, пропорциональную длительности отрезка.
+func (t HeartRateTraining) EvenSplits(n int) []InfoMessage {
+	return evenSplits(n, t.TrainingType, t.distance(), t.Duration, t.Calories(), PacePerKm, 1)
+}
+
+// StrengthSet описывает один подход силовой тренировки.
+type StrengthSet struct {
+	Reps        int           // количество повторений в подходе
+	WeightKg    float64       // рабочий вес, кг
+	ExerciseMET float64       // MET упражнения
+	RestBetween time.Duration // отдых после подхода
+}
+
+// Strength структура, описывающая силовую тренировку (тренажерный зал, калистеника),
+// не имеющую дистанции или шагов.
+type Strength struct {
+	Training
+	Sets []StrengthSet // подходы тренировки
+}
+
+// distance для силовой тренировки отсутствует, так как нет перемещения по маршруту.
+func (s Strength) distance() float64 {
+	return 0
+}
+
+// meanSpeed для силовой тренировки отсутствует, так как нет перемещения по маршруту.
+func (s Strength) meanSpeed() float64 {
+	return 0
+}
+
+// meanMET возвращает средний MET по всем подходам тренировки.
+func (s Strength) meanMET() float64 {
+	if len(s.Sets) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, set := range s.Sets {
+		sum += set.ExerciseMET
+	}
+	return sum / float64(len(s.Sets))
+}
+
+// volume возвращает суммарный объем тренировки (Σ повторения × вес).
+func (s Strength) volume() float64 {
+	var v float64
+	for _, set := range s.Sets {
+		v += float64(set.Reps) * set.WeightKg
+	}
+	return v
+}
+
+// activeDuration возвращает длительность тренировки за вычетом отдыха между подходами.
+func (s Strength) activeDuration() time.Duration {
+	var rest time.Duration
+	for _, set := range s.Sets {
+		rest += set.RestBetween
+	}
+	active := s.Duration - rest
+	if active < 0 {
+		return 0
+	}
+	return active
+}
+
+// Calories возвращает количество потраченных килокалорий при силовой тренировке.
+func (s Strength) Calories() float64 {
+	return s.meanMET() * METCaloriesMultiplier * s.Weight / METCaloriesWeightDivider * s.activeDuration().Minutes()
+}
+
+// TrainingInfo возвращает структуру InfoMessage с информацией о проведенной тренировке.
+func (s Strength) TrainingInfo() InfoMessage {
+	return InfoMessage{
+		TrainingType: s.TrainingType,
+		Duration:     s.Duration,
+		Distance:     s.distance(),
+		Speed:        s.meanSpeed(),
+		Calories:     s.Calories(),
+		Volume:       s.volume(),
+		SetCount:     len(s.Sets),
+		Laps:         s.Laps,
+		WeightKg:     s.Weight,
+	}
+}
+
+// EvenSplits делит подходы тренировки на n примерно равных групп (по
+// количеству подходов) и возвращает объем, количество подходов и долю
+// калорий, пропорциональную длительности группы. Если n больше числа
+// подходов, групп столько же, сколько подходов; без подходов возвращает nil.
+func (s Strength) EvenSplits(n int) []InfoMessage {
+	if n <= 0 || len(s.Sets) == 0 {
+		return nil
+	}
+	if n > len(s.Sets) {
+		n = len(s.Sets)
+	}
+
+	totalCalories := s.Calories()
+	splits := make([]InfoMessage, 0, n)
+	base, rem := len(s.Sets)/n, len(s.Sets)%n
+	idx := 0
+	for i := 0; i < n; i++ {
+		size := base
+		if i < rem {
+			size++
+		}
+		sub := Strength{
+			Training: Training{
+				TrainingType: s.TrainingType,
+				Duration:     time.Duration(int64(s.Duration) * int64(size) / int64(len(s.Sets))),
+				Weight:       s.Weight,
+			},
+			Sets: s.Sets[idx : idx+size],
+		}
+		idx += size
+
+		info := sub.TrainingInfo()
+		if s.Duration > 0 {
+			info.Calories = totalCalories * sub.Duration.Minutes() / s.Duration.Minutes()
+		}
+		splits = append(splits, info)
+	}
+	return splits
+}
+
+func ReadData(training CaloriesCalculator) string {
+	info := training.TrainingInfo()
+	return fmt.Sprint(info)
+}
@@ -0,0 +1,222 @@
+package fittrack
+
+import (
+	"math"
+	"strings"
+	"testing"
+	"time"
+)
+
+// approxEqual сравнивает float64 с допуском, достаточным для денежных/временных
+// величин, округляемых до сотых при выводе.
+func approxEqual(got, want, epsilon float64) bool {
+	return math.Abs(got-want) <= epsilon
+}
+
+func TestHeartRate_Calories(t *testing.T) {
+	cases := []struct {
+		name     string
+		hr       HeartRate
+		weight   float64
+		duration time.Duration
+		want     float64
+	}{
+		{
+			name: "male",
+			hr: HeartRate{
+				Samples:        []int{120, 130, 140},
+				SampleInterval: time.Minute,
+				Age:            30,
+				Sex:            SexMale,
+				RestingHR:      60,
+				MaxHR:          180,
+			},
+			weight:   70,
+			duration: 30 * time.Minute,
+			want:     336.19,
+		},
+		{
+			name: "female",
+			hr: HeartRate{
+				Samples:        []int{110, 120, 130},
+				SampleInterval: time.Minute,
+				Age:            28,
+				Sex:            SexFemale,
+				RestingHR:      60,
+				MaxHR:          170,
+			},
+			weight:   60,
+			duration: 45 * time.Minute,
+			want:     298.52,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := c.hr.Calories(c.weight, c.duration)
+			if !approxEqual(got, c.want, 0.01) {
+				t.Errorf("Calories() = %.2f, want %.2f", got, c.want)
+			}
+		})
+	}
+}
+
+func TestHeartRate_Zones(t *testing.T) {
+	// Резерв пульса (MaxHR-RestingHR) = 120, границы зон: 120/132/144/156/168/180.
+	hr := HeartRate{
+		Samples:        []int{100, 130, 150, 165, 178},
+		SampleInterval: time.Minute,
+		RestingHR:      60,
+		MaxHR:          180,
+	}
+
+	zones := hr.Zones()
+	want := [5]time.Duration{
+		time.Minute, // 130 попадает в 50-60%
+		0,           // ни один замер не попадает в 60-70%
+		time.Minute, // 150 попадает в 70-80%
+		time.Minute, // 165 попадает в 80-90%
+		time.Minute, // 178 попадает в 90-100%
+	}
+	if zones != want {
+		t.Errorf("Zones() = %v, want %v (замер 100 ниже 50%% резерва и не учитывается ни в одной зоне)", zones, want)
+	}
+}
+
+func TestStrength_Calories(t *testing.T) {
+	s := Strength{
+		Training: Training{
+			Weight:   80,
+			Duration: 60 * time.Minute,
+		},
+		Sets: []StrengthSet{
+			{Reps: 10, WeightKg: 50, ExerciseMET: 6, RestBetween: 2 * time.Minute},
+			{Reps: 8, WeightKg: 60, ExerciseMET: 8, RestBetween: 3 * time.Minute},
+		},
+	}
+
+	// meanMET=7, activeDuration=60-5=55 мин: 7*3.5*80/200*55 = 539.
+	if got, want := s.Calories(), 539.0; !approxEqual(got, want, 0.001) {
+		t.Errorf("Calories() = %v, want %v", got, want)
+	}
+	if got, want := s.volume(), 980.0; got != want {
+		t.Errorf("volume() = %v, want %v", got, want)
+	}
+	if got, want := s.distance(), 0.0; got != want {
+		t.Errorf("distance() = %v, want %v (силовая тренировка не имеет дистанции)", got, want)
+	}
+	if got, want := s.meanSpeed(), 0.0; got != want {
+		t.Errorf("meanSpeed() = %v, want %v (силовая тренировка не имеет скорости)", got, want)
+	}
+}
+
+func TestDecimalMinutesToMinSec(t *testing.T) {
+	cases := []struct {
+		in   float64
+		want string
+	}{
+		{5.5, "5:30"},
+		{9.99, "9:59"},
+		{3.999, "4:00"}, // округление секунд до 60 переносится в следующую минуту
+	}
+
+	for _, c := range cases {
+		if got := decimalMinutesToMinSec(c.in); got != c.want {
+			t.Errorf("decimalMinutesToMinSec(%v) = %q, want %q", c.in, got, c.want)
+		}
+	}
+}
+
+func TestEvenSplits(t *testing.T) {
+	splits := evenSplits(3, "Бег", 9.0, 90*time.Minute, 450, PacePerKm, 1)
+	if len(splits) != 3 {
+		t.Fatalf("evenSplits: got %d splits, want 3", len(splits))
+	}
+
+	var gotDistance float64
+	var gotDuration time.Duration
+	var gotCalories float64
+	for _, split := range splits {
+		if split.TrainingType != "Бег" {
+			t.Errorf("split.TrainingType = %q, want %q", split.TrainingType, "Бег")
+		}
+		gotDistance += split.Distance
+		gotDuration += split.Duration
+		gotCalories += split.Calories
+	}
+
+	if !approxEqual(gotDistance, 9.0, 0.0001) {
+		t.Errorf("sum of split.Distance = %v, want 9.0", gotDistance)
+	}
+	if gotDuration != 90*time.Minute {
+		t.Errorf("sum of split.Duration = %v, want 90m", gotDuration)
+	}
+	if !approxEqual(gotCalories, 450, 0.0001) {
+		t.Errorf("sum of split.Calories = %v, want 450", gotCalories)
+	}
+
+	// При ровном делении (90/3=30 мин) все отрезки одинаковы.
+	want := InfoMessage{TrainingType: "Бег", Duration: 30 * time.Minute, Distance: 3.0, Speed: 6.0, Pace: 10.0, PaceUnit: PacePerKm, Calories: 150}
+	for i, split := range splits {
+		if split.Duration != want.Duration || !approxEqual(split.Distance, want.Distance, 0.0001) ||
+			!approxEqual(split.Speed, want.Speed, 0.0001) || !approxEqual(split.Pace, want.Pace, 0.0001) ||
+			!approxEqual(split.Calories, want.Calories, 0.0001) {
+			t.Errorf("splits[%d] = %+v, want %+v", i, split, want)
+		}
+	}
+}
+
+func TestFormatter_Format_Imperial(t *testing.T) {
+	info := InfoMessage{
+		TrainingType: "Бег",
+		Duration:     30 * time.Minute,
+		Distance:     10,
+		Speed:        12,
+		Calories:     500,
+		WeightKg:     70,
+		Pace:         5.0,
+		PaceUnit:     PacePerKm,
+	}
+
+	got := Formatter{Units: Imperial, Locale: EN}.Format(info)
+	for _, want := range []string{
+		"Distance: 6.21 mi.",
+		"Avg speed: 7.46 mph",
+		"Weight: 154.3 lb",
+		"Pace: 8:03 min/mi", // 5.0 мин/км -> мин/милю: 5.0/0.621371
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("Format(Imperial) = %q, want it to contain %q", got, want)
+		}
+	}
+}
+
+func TestCycling_Calories(t *testing.T) {
+	t.Run("power-based", func(t *testing.T) {
+		c := Cycling{
+			Training:  Training{Duration: 60 * time.Minute},
+			AvgPowerW: 200,
+		}
+		if got, want := c.Calories(), 717.02; !approxEqual(got, want, 0.01) {
+			t.Errorf("Calories() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("MET fallback", func(t *testing.T) {
+		c := Cycling{
+			Training: Training{
+				Action:   5000,
+				LenStep:  4,
+				Duration: 60 * time.Minute,
+				Weight:   80,
+			},
+		}
+		// distance=20 км, скорость=20 км/ч -> попадает в бакет CyclingMETHigh (8 MET).
+		if got, want := c.meanSpeedMET(), float64(CyclingMETHigh); got != want {
+			t.Fatalf("meanSpeedMET() = %v, want %v", got, want)
+		}
+		if got, want := c.Calories(), 672.0; !approxEqual(got, want, 0.001) {
+			t.Errorf("Calories() = %v, want %v", got, want)
+		}
+	})
+}